@@ -0,0 +1,218 @@
+package config
+
+// This file adds two families of accessors on top of the Get*/GetRequired*
+// methods defined in config.go:
+//
+//   - Try* : like Get* but never panics on a type mismatch, returning
+//     ErrWrongType instead of letting the underlying type assertion crash
+//   - Default* : like Try* but swallows the error and returns a
+//     caller-supplied default value when the key is missing or of the
+//     wrong type
+
+func (c *Config) TryString(key string) (string, error) {
+	val, ok := (*c)[key]
+	if !ok {
+		return "", ErrNoSuchKey
+	}
+	s, ok := val.(string)
+	if !ok {
+		return "", ErrWrongType
+	}
+	return s, nil
+}
+
+func (c *Config) TryBool(key string) (bool, error) {
+	val, ok := (*c)[key]
+	if !ok {
+		return false, ErrNoSuchKey
+	}
+	b, ok := val.(bool)
+	if !ok {
+		return false, ErrWrongType
+	}
+	return b, nil
+}
+
+func (c *Config) TryFloat64(key string) (float64, error) {
+	val, ok := (*c)[key]
+	if !ok {
+		return 0, ErrNoSuchKey
+	}
+	f, ok := val.(float64)
+	if !ok {
+		return 0, ErrWrongType
+	}
+	return f, nil
+}
+
+func (c *Config) TryInt(key string) (int, error) {
+	f, err := c.TryFloat64(key)
+	if err != nil {
+		return 0, err
+	}
+	return int(f), nil
+}
+
+func (c *Config) TryInt64(key string) (int64, error) {
+	f, err := c.TryFloat64(key)
+	if err != nil {
+		return 0, err
+	}
+	return int64(f), nil
+}
+
+func (c *Config) TryUint(key string) (uint, error) {
+	f, err := c.TryFloat64(key)
+	if err != nil {
+		return 0, err
+	}
+	return uint(f), nil
+}
+
+func (c *Config) TryUint64(key string) (uint64, error) {
+	f, err := c.TryFloat64(key)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(f), nil
+}
+
+func (c *Config) TryStringSlice(key string) ([]string, error) {
+	val, ok := (*c)[key]
+	if !ok {
+		return nil, ErrNoSuchKey
+	}
+	s, ok := val.([]interface{})
+	if !ok {
+		return nil, ErrWrongType
+	}
+	out := make([]string, 0, len(s))
+	for _, e := range s {
+		v, ok := e.(string)
+		if !ok {
+			return nil, ErrWrongType
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func (c *Config) TryInt64Slice(key string) ([]int64, error) {
+	val, ok := (*c)[key]
+	if !ok {
+		return nil, ErrNoSuchKey
+	}
+	s, ok := val.([]interface{})
+	if !ok {
+		return nil, ErrWrongType
+	}
+	out := make([]int64, 0, len(s))
+	for _, e := range s {
+		v, ok := e.(float64)
+		if !ok {
+			return nil, ErrWrongType
+		}
+		out = append(out, int64(v))
+	}
+	return out, nil
+}
+
+func (c *Config) TryUint64Slice(key string) ([]uint64, error) {
+	val, ok := (*c)[key]
+	if !ok {
+		return nil, ErrNoSuchKey
+	}
+	s, ok := val.([]interface{})
+	if !ok {
+		return nil, ErrWrongType
+	}
+	out := make([]uint64, 0, len(s))
+	for _, e := range s {
+		v, ok := e.(float64)
+		if !ok {
+			return nil, ErrWrongType
+		}
+		out = append(out, uint64(v))
+	}
+	return out, nil
+}
+
+func (c *Config) DefaultString(key, def string) string {
+	v, err := c.TryString(key)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+func (c *Config) DefaultBool(key string, def bool) bool {
+	v, err := c.TryBool(key)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+func (c *Config) DefaultFloat64(key string, def float64) float64 {
+	v, err := c.TryFloat64(key)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+func (c *Config) DefaultInt(key string, def int) int {
+	v, err := c.TryInt(key)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+func (c *Config) DefaultInt64(key string, def int64) int64 {
+	v, err := c.TryInt64(key)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+func (c *Config) DefaultUint(key string, def uint) uint {
+	v, err := c.TryUint(key)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+func (c *Config) DefaultUint64(key string, def uint64) uint64 {
+	v, err := c.TryUint64(key)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+func (c *Config) DefaultStringSlice(key string, def []string) []string {
+	v, err := c.TryStringSlice(key)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+func (c *Config) DefaultInt64Slice(key string, def []int64) []int64 {
+	v, err := c.TryInt64Slice(key)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+func (c *Config) DefaultUint64Slice(key string, def []uint64) []uint64 {
+	v, err := c.TryUint64Slice(key)
+	if err != nil {
+		return def
+	}
+	return v
+}
@@ -0,0 +1,63 @@
+package config
+
+import "testing"
+
+func TestTryAccessors(t *testing.T) {
+	c, err := Loads([]byte(`{"name":"svc","count":3,"ratio":1.5,"on":true,"tags":["a","b"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := c.TryString("name"); err != nil || v != "svc" {
+		t.Fatalf("TryString = %q, %v", v, err)
+	}
+	if _, err := c.TryString("count"); err != ErrWrongType {
+		t.Fatalf("TryString on wrong type = %v, want ErrWrongType", err)
+	}
+	if _, err := c.TryString("missing"); err != ErrNoSuchKey {
+		t.Fatalf("TryString on missing key = %v, want ErrNoSuchKey", err)
+	}
+
+	if v, err := c.TryInt64("count"); err != nil || v != 3 {
+		t.Fatalf("TryInt64 = %d, %v", v, err)
+	}
+	if v, err := c.TryBool("on"); err != nil || v != true {
+		t.Fatalf("TryBool = %v, %v", v, err)
+	}
+	if v, err := c.TryStringSlice("tags"); err != nil || len(v) != 2 || v[0] != "a" || v[1] != "b" {
+		t.Fatalf("TryStringSlice = %v, %v", v, err)
+	}
+}
+
+func TestDefaultAccessors(t *testing.T) {
+	c, err := Loads([]byte(`{"name":"svc"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := c.DefaultString("name", "fallback"); v != "svc" {
+		t.Fatalf("DefaultString present = %q", v)
+	}
+	if v := c.DefaultString("missing", "fallback"); v != "fallback" {
+		t.Fatalf("DefaultString missing = %q", v)
+	}
+	if v := c.DefaultInt64("missing", 42); v != 42 {
+		t.Fatalf("DefaultInt64 missing = %d", v)
+	}
+	if v := c.DefaultBool("missing", true); v != true {
+		t.Fatalf("DefaultBool missing = %v", v)
+	}
+}
+
+func TestGetBool(t *testing.T) {
+	c, err := Loads([]byte(`{"enabled":true}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := c.GetBool("enabled"); err != nil || !v {
+		t.Fatalf("GetBool = %v, %v", v, err)
+	}
+	if _, err := c.GetBool("missing"); err != ErrNoSuchKey {
+		t.Fatalf("GetBool missing = %v, want ErrNoSuchKey", err)
+	}
+}
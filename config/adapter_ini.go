@@ -0,0 +1,44 @@
+package config
+
+import (
+	"gopkg.in/ini.v1"
+)
+
+// iniSectionSep : separator used to flatten "[section]\nkey = value" entries
+// into a single level Config map, e.g. Get("database::host")
+const iniSectionSep = "::"
+
+// iniProvider : Provider implementation backed by gopkg.in/ini.v1. Keys are
+// exposed as "section::key" ("::key" for the default/unnamed section) so
+// that Config.Get keeps working on a flat map.
+type iniProvider struct{}
+
+func (iniProvider) Parse(path string) (Config, error) {
+	f, err := ini.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return flattenINI(f), nil
+}
+
+func (iniProvider) ParseData(data []byte) (Config, error) {
+	f, err := ini.Load(data)
+	if err != nil {
+		return nil, err
+	}
+	return flattenINI(f), nil
+}
+
+func flattenINI(f *ini.File) Config {
+	c := Config{}
+	for _, section := range f.Sections() {
+		name := section.Name()
+		if name == ini.DefaultSection {
+			name = ""
+		}
+		for _, key := range section.Keys() {
+			c[name+iniSectionSep+key.Name()] = key.Value()
+		}
+	}
+	return c
+}
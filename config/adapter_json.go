@@ -0,0 +1,17 @@
+package config
+
+// jsonProvider : Provider implementation backed by the standard library
+// encoding/json, equivalent to the historical Load/Loads behaviour
+type jsonProvider struct{}
+
+func (jsonProvider) Parse(path string) (Config, error) {
+	data, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return jsonProvider{}.ParseData(data)
+}
+
+func (jsonProvider) ParseData(data []byte) (Config, error) {
+	return Loads(data)
+}
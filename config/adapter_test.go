@@ -0,0 +1,105 @@
+package config
+
+import "testing"
+
+func TestNewConfigDataJSON(t *testing.T) {
+	c, err := NewConfigData("json", []byte(`{"name":"svc","port":8080}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := c.GetString("name"); v != "svc" {
+		t.Fatalf("name = %q", v)
+	}
+	if v, err := c.TryInt64("port"); err != nil || v != 8080 {
+		t.Fatalf("port = %v, %v", v, err)
+	}
+}
+
+func TestNewConfigDataYAML(t *testing.T) {
+	c, err := NewConfigData("yaml", []byte("name: svc\nport: 8080\nnested:\n  count: 3\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := c.GetString("name"); v != "svc" {
+		t.Fatalf("name = %q", v)
+	}
+	// yaml.v2 decodes integers as int, not float64; make sure the adapter
+	// normalizes it so numeric accessors don't panic/ErrWrongType.
+	if v, err := c.TryInt64("port"); err != nil || v != 8080 {
+		t.Fatalf("port = %v, %v", v, err)
+	}
+	sub, err := c.GetSubConfig("nested")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := sub.TryInt64("count"); err != nil || v != 3 {
+		t.Fatalf("nested.count = %v, %v", v, err)
+	}
+}
+
+func TestNewConfigDataTOML(t *testing.T) {
+	c, err := NewConfigData("toml", []byte("name = \"svc\"\nport = 8080\n\n[nested]\ncount = 3\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := c.GetString("name"); v != "svc" {
+		t.Fatalf("name = %q", v)
+	}
+	// BurntSushi/toml decodes integers as int64, not float64; make sure the
+	// adapter normalizes it so numeric accessors don't panic/ErrWrongType.
+	if v, err := c.TryInt64("port"); err != nil || v != 8080 {
+		t.Fatalf("port = %v, %v", v, err)
+	}
+	sub, err := c.GetSubConfig("nested")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := sub.TryInt64("count"); err != nil || v != 3 {
+		t.Fatalf("nested.count = %v, %v", v, err)
+	}
+}
+
+func TestNewConfigDataINI(t *testing.T) {
+	c, err := NewConfigData("ini", []byte("key=val\n\n[section]\nk2=v2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the unnamed/default section should surface as "::key", not "DEFAULT::key"
+	if v, err := c.TryString("::key"); err != nil || v != "val" {
+		t.Fatalf("default section key = %v, %v, want val", v, err)
+	}
+	if v, err := c.TryString("section::k2"); err != nil || v != "v2" {
+		t.Fatalf("section::k2 = %v, %v, want v2", v, err)
+	}
+}
+
+func TestNewConfigUnknownAdapter(t *testing.T) {
+	if _, err := NewConfigData("xml", []byte(`whatever`)); err == nil {
+		t.Fatal("expected an error for an unregistered adapter")
+	}
+}
+
+func TestRegisterCustomProvider(t *testing.T) {
+	Register("const", constProvider{})
+	defer delete(providers, "const")
+
+	c, err := NewConfigData("const", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := c.GetString("always"); v != "there" {
+		t.Fatalf("name = %q", v)
+	}
+}
+
+// constProvider : trivial Provider used to verify third parties can register
+// their own adapters without touching this package
+type constProvider struct{}
+
+func (constProvider) Parse(path string) (Config, error) {
+	return constProvider{}.ParseData(nil)
+}
+
+func (constProvider) ParseData(data []byte) (Config, error) {
+	return Config{"always": "there"}, nil
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"github.com/BurntSushi/toml"
+)
+
+// tomlProvider : Provider implementation backed by github.com/BurntSushi/toml
+type tomlProvider struct{}
+
+func (tomlProvider) Parse(path string) (Config, error) {
+	data, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return tomlProvider{}.ParseData(data)
+}
+
+func (tomlProvider) ParseData(data []byte) (Config, error) {
+	raw := map[string]interface{}{}
+	if _, err := toml.Decode(string(data), &raw); err != nil {
+		return nil, err
+	}
+	return asConfig(normalizeTOML(raw).(map[string]interface{})), nil
+}
+
+// normalizeTOML : BurntSushi/toml decodes integers as int64, whereas every
+// other accessor in this package (and every other adapter) assumes numbers
+// come back as float64, the type encoding/json produces. Recursively convert
+// int64 to float64 so TOML-sourced values behave the same as JSON/YAML ones.
+func normalizeTOML(in interface{}) interface{} {
+	switch v := in.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			out[key] = normalizeTOML(val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			out[i] = normalizeTOML(val)
+		}
+		return out
+	case int64:
+		return float64(v)
+	default:
+		return v
+	}
+}
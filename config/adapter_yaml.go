@@ -0,0 +1,61 @@
+package config
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// yamlProvider : Provider implementation backed by gopkg.in/yaml.v2
+type yamlProvider struct{}
+
+func (yamlProvider) Parse(path string) (Config, error) {
+	data, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return yamlProvider{}.ParseData(data)
+}
+
+func (yamlProvider) ParseData(data []byte) (c Config, err error) {
+	raw := map[string]interface{}{}
+	if err = yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	return asConfig(normalizeYAML(raw).(map[string]interface{})), nil
+}
+
+// normalizeYAML : recursively converts map[interface{}]interface{} values
+// produced by yaml.v2 into map[string]interface{} so that the rest of the
+// package can treat every nested Config the same way regardless of the
+// source format. It also converts yaml.v2's int into float64, since every
+// numeric accessor in this package (and the JSON adapter) assumes numbers
+// decode to float64, the type encoding/json produces.
+func normalizeYAML(in interface{}) interface{} {
+	switch v := in.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			out[fmt.Sprintf("%v", key)] = normalizeYAML(val)
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			out[key] = normalizeYAML(val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			out[i] = normalizeYAML(val)
+		}
+		return out
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,149 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// bindTag : struct tag used to drive BindStruct/Bind field mapping
+const bindTag = "config"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// BindStruct : unmarshals the subtree referenced by key into out, which must
+// be a pointer to a struct. Field mapping honors the `config:"name,omitempty"`
+// tag, falling back to the Go field name when absent. Nested structs, slices,
+// maps and time.Duration (parsed from strings such as "30s") are supported.
+func (c *Config) BindStruct(key string, out interface{}) error {
+	val, err := c.Get(key)
+	if err != nil {
+		return err
+	}
+	sub, ok := val.(map[string]interface{})
+	if !ok {
+		return ErrWrongType
+	}
+	cfg := asConfig(sub)
+	return cfg.Bind(out)
+}
+
+// Bind : unmarshals the whole Config into out, which must be a pointer to a
+// struct. See BindStruct for the supported tag and type conversions.
+func (c *Config) Bind(out interface{}) error {
+	prepared, err := prepareForType(reflect.TypeOf(out), asMap(*c))
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(prepared)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, out)
+}
+
+// prepareForType : walks t's fields and rewrites in so that encoding/json,
+// which only understands `json` tags, can unmarshal it into t directly. This
+// means renaming keys from their `config` tag name to the matching Go field
+// name, recursing into nested structs, and turning duration-typed fields
+// (e.g. "30s") into the nanosecond count json.Unmarshal expects for an int64.
+func prepareForType(t reflect.Type, in map[string]interface{}) (map[string]interface{}, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return in, nil
+	}
+
+	out := make(map[string]interface{}, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Name
+		if tag, ok := field.Tag.Lookup(bindTag); ok {
+			if tagName := strings.Split(tag, ",")[0]; tagName != "" {
+				if val, ok := out[tagName]; ok && tagName != name {
+					delete(out, tagName)
+					out[name] = val
+				}
+			}
+		}
+
+		val, ok := out[name]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case field.Type == durationType:
+			d, err := toDuration(val)
+			if err != nil {
+				return nil, err
+			}
+			out[name] = d
+
+		case isStructish(field.Type):
+			sub, ok := val.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			nested, err := prepareForType(field.Type, sub)
+			if err != nil {
+				return nil, err
+			}
+			out[name] = nested
+
+		case field.Type.Kind() == reflect.Slice && isStructish(field.Type.Elem()):
+			items, ok := val.([]interface{})
+			if !ok {
+				continue
+			}
+			elemType := field.Type.Elem()
+			prepared := make([]interface{}, len(items))
+			for i, item := range items {
+				sub, ok := item.(map[string]interface{})
+				if !ok {
+					prepared[i] = item
+					continue
+				}
+				nested, err := prepareForType(elemType, sub)
+				if err != nil {
+					return nil, err
+				}
+				prepared[i] = nested
+			}
+			out[name] = prepared
+		}
+	}
+	return out, nil
+}
+
+// isStructish : reports whether t is a struct or a pointer to one, the two
+// shapes prepareForType recurses into to apply tag renaming
+func isStructish(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct || (t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct)
+}
+
+// toDuration : accepts either a duration string ("30s") or a pre-parsed
+// number of nanoseconds and returns the int64 encoding/json needs for a
+// time.Duration field
+func toDuration(val interface{}) (int64, error) {
+	switch v := val.(type) {
+	case string:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, err
+		}
+		return int64(d), nil
+	case float64:
+		return int64(v), nil
+	default:
+		return 0, nil
+	}
+}
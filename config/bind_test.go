@@ -0,0 +1,98 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBindBasic(t *testing.T) {
+	c, err := Loads([]byte(`{"nm":"svc","port":8080,"timeout":"30s"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out struct {
+		Name    string        `config:"nm"`
+		Port    int           `config:"port"`
+		Timeout time.Duration `config:"timeout"`
+	}
+	if err := c.Bind(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Name != "svc" || out.Port != 8080 || out.Timeout != 30*time.Second {
+		t.Fatalf("got %+v", out)
+	}
+}
+
+func TestBindNestedStruct(t *testing.T) {
+	c, err := Loads([]byte(`{"db":{"hst":"localhost","port":5432}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type db struct {
+		Host string `config:"hst"`
+		Port int
+	}
+	var out struct {
+		DB db `config:"db"`
+	}
+	if err := c.Bind(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.DB.Host != "localhost" || out.DB.Port != 5432 {
+		t.Fatalf("got %+v", out.DB)
+	}
+}
+
+func TestBindSliceOfStructsTag(t *testing.T) {
+	c, err := Loads([]byte(`{"items":[{"nm":"a"},{"nm":"b"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type item struct {
+		DisplayName string `config:"nm"`
+	}
+	var out struct {
+		Items []item `config:"items"`
+	}
+	if err := c.Bind(&out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Items) != 2 || out.Items[0].DisplayName != "a" || out.Items[1].DisplayName != "b" {
+		t.Fatalf("got %+v", out.Items)
+	}
+}
+
+func TestBindStructSubKey(t *testing.T) {
+	c, err := Loads([]byte(`{"server":{"hst":"0.0.0.0"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type server struct {
+		Host string `config:"hst"`
+	}
+	var out server
+	if err := c.BindStruct("server", &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Host != "0.0.0.0" {
+		t.Fatalf("got %+v", out)
+	}
+}
+
+func TestBindStructWrongType(t *testing.T) {
+	c, err := Loads([]byte(`{"server":"oops"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out struct {
+		Host string
+	}
+	if err := c.BindStruct("server", &out); err != ErrWrongType {
+		t.Fatalf("err = %v, want ErrWrongType", err)
+	}
+}
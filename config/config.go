@@ -18,6 +18,7 @@ type Value interface{}
 
 var (
 	ErrNoSuchKey = errors.New("No such key")
+	ErrWrongType = errors.New("Value is of the wrong type")
 )
 
 // Loads : loads a configuration structure from a data buffer
@@ -93,6 +94,28 @@ func (c *Config) GetUint64(key string) (uint64, error) {
 	return uint64(val.(float64)), nil
 }
 
+func (c *Config) GetBool(key string) (bool, error) {
+	val, ok := (*c)[key]
+	if !ok {
+		return false, ErrNoSuchKey
+	}
+	return val.(bool), nil
+}
+
+// asMap : reinterprets c as a plain map[string]interface{}. Config's element
+// type Value is a named interface{}, and Go's map conversion rules require
+// identical element types, so a direct map[string]interface{}(c) conversion
+// is illegal; this mirrors the unsafe.Pointer trick already used below by
+// GetSubConfig.
+func asMap(c Config) map[string]interface{} {
+	return *(*map[string]interface{})(unsafe.Pointer(&c))
+}
+
+// asConfig : the inverse of asMap
+func asConfig(m map[string]interface{}) Config {
+	return *(*Config)(unsafe.Pointer(&m))
+}
+
 // GetSubConfig : get a subconfig referenced by key
 // return (Config, error)
 func (c *Config) GetSubConfig(key string) (Config, error) {
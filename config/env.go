@@ -0,0 +1,150 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// envNestingSep : separator used inside an env var name to denote descending
+// into a nested map, e.g. APP_DATABASE__HOST overrides config["database"]["host"]
+const envNestingSep = "__"
+
+// LoadWithEnv : loads the configuration stored at path as JSON, then applies
+// environment variable overrides: any variable named "<PREFIX>_<UPPER_KEY>"
+// overrides the corresponding top level key, with "__" denoting a descent
+// into a nested map (e.g. APP_DATABASE__HOST -> config["database"]["host"]).
+// Each override is coerced to match the existing key's type (float64/bool),
+// so overriding a numeric or boolean key keeps working with TryInt64,
+// TryBool, etc.
+func LoadWithEnv(path, prefix string) (Config, error) {
+	c, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	c.overlayEnv(prefix)
+	return c, nil
+}
+
+// overlayEnv : applies environment variable overrides in place, see LoadWithEnv
+func (c *Config) overlayEnv(prefix string) {
+	envPrefix := prefix + "_"
+	for _, kv := range os.Environ() {
+		name, value, ok := splitEnv(kv)
+		if !ok || !strings.HasPrefix(name, envPrefix) {
+			continue
+		}
+		path := strings.Split(strings.ToLower(strings.TrimPrefix(name, envPrefix)), envNestingSep)
+		setNested(asMap(*c), path, value)
+	}
+}
+
+func splitEnv(kv string) (name, value string, ok bool) {
+	i := strings.IndexByte(kv, '=')
+	if i < 0 {
+		return "", "", false
+	}
+	return kv[:i], kv[i+1:], true
+}
+
+// setNested : writes value at the location described by path inside m,
+// creating intermediate maps as needed. value is coerced to match the type
+// of whatever already lives at that key (float64/bool), so overriding e.g.
+// a numeric "port" via an env var keeps it usable through TryInt64 and
+// friends instead of silently turning it into a string.
+func setNested(m map[string]interface{}, path []string, value string) {
+	for len(path) > 1 {
+		key := path[0]
+		next, ok := m[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			m[key] = next
+		}
+		m = next
+		path = path[1:]
+	}
+	key := path[0]
+	m[key] = coerceEnvValue(m[key], value)
+}
+
+// coerceEnvValue : parses value as the same dynamic type as existing (the
+// value previously stored at that key, or nil if the key is new), falling
+// back to the raw string when existing is a string, unset, or value doesn't
+// parse as the expected type
+func coerceEnvValue(existing interface{}, value string) interface{} {
+	switch existing.(type) {
+	case float64:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case bool:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
+// ExpandEnv : walks all string values in the Config and rewrites "${VAR}" and
+// "${VAR|default}" tokens using os.LookupEnv, falling back to the provided
+// default (or leaving an unset "${VAR}" untouched) when the variable is unset
+func (c *Config) ExpandEnv() {
+	expandEnvMap(asMap(*c))
+}
+
+func expandEnvMap(m map[string]interface{}) {
+	for k, v := range m {
+		m[k] = expandEnvValue(v)
+	}
+}
+
+func expandEnvValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case string:
+		return expandEnvString(val)
+	case map[string]interface{}:
+		expandEnvMap(val)
+		return val
+	case []interface{}:
+		for i, e := range val {
+			val[i] = expandEnvValue(e)
+		}
+		return val
+	default:
+		return val
+	}
+}
+
+// expandEnvString : rewrites every "${VAR}" / "${VAR|default}" token found in s
+func expandEnvString(s string) string {
+	var out strings.Builder
+	for {
+		start := strings.Index(s, "${")
+		if start < 0 {
+			out.WriteString(s)
+			break
+		}
+		end := strings.IndexByte(s[start:], '}')
+		if end < 0 {
+			out.WriteString(s)
+			break
+		}
+		end += start
+
+		out.WriteString(s[:start])
+		token := s[start+2 : end]
+		name, def, hasDef := token, "", false
+		if i := strings.IndexByte(token, '|'); i >= 0 {
+			name, def, hasDef = token[:i], token[i+1:], true
+		}
+		if value, ok := os.LookupEnv(name); ok {
+			out.WriteString(value)
+		} else if hasDef {
+			out.WriteString(def)
+		} else {
+			out.WriteString(s[start : end+1])
+		}
+		s = s[end+1:]
+	}
+	return out.String()
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadWithEnvOverride(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(path, []byte(`{"name":"base","database":{"host":"localhost"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	os.Setenv("APP_NAME", "overridden")
+	os.Setenv("APP_DATABASE__HOST", "db.internal")
+	defer os.Unsetenv("APP_NAME")
+	defer os.Unsetenv("APP_DATABASE__HOST")
+
+	c, err := LoadWithEnv(path, "APP")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, _ := c.GetString("name"); v != "overridden" {
+		t.Fatalf("name = %q, want overridden", v)
+	}
+	sub, err := c.GetSubConfig("database")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := sub.GetString("host"); v != "db.internal" {
+		t.Fatalf("database.host = %q, want db.internal", v)
+	}
+}
+
+func TestLoadWithEnvCoercesType(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(path, []byte(`{"port":8080,"debug":false}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	os.Setenv("APP_PORT", "9090")
+	os.Setenv("APP_DEBUG", "true")
+	defer os.Unsetenv("APP_PORT")
+	defer os.Unsetenv("APP_DEBUG")
+
+	c, err := LoadWithEnv(path, "APP")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := c.TryInt64("port"); err != nil || v != 9090 {
+		t.Fatalf("port = %v, %v, want 9090 (int64, not a string)", v, err)
+	}
+	if v, err := c.TryBool("debug"); err != nil || v != true {
+		t.Fatalf("debug = %v, %v, want true (bool, not a string)", v, err)
+	}
+}
+
+func TestLoadWithEnvIgnoresOtherPrefixes(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(path, []byte(`{"name":"base"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	os.Setenv("OTHER_NAME", "shouldnotapply")
+	defer os.Unsetenv("OTHER_NAME")
+
+	c, err := LoadWithEnv(path, "APP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := c.GetString("name"); v != "base" {
+		t.Fatalf("name = %q, want base", v)
+	}
+}
+
+func TestExpandEnv(t *testing.T) {
+	c, err := Loads([]byte(`{"url":"postgres://${DB_HOST|localhost}:5432","unset":"${NOT_SET_AT_ALL}"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	os.Setenv("DB_HOST", "db.internal")
+	defer os.Unsetenv("DB_HOST")
+
+	c.ExpandEnv()
+
+	if v, _ := c.GetString("url"); v != "postgres://db.internal:5432" {
+		t.Fatalf("url = %q", v)
+	}
+	if v, _ := c.GetString("unset"); v != "${NOT_SET_AT_ALL}" {
+		t.Fatalf("unset = %q, want token left untouched", v)
+	}
+}
+
+func TestExpandEnvDefaultFallback(t *testing.T) {
+	c, err := Loads([]byte(`{"url":"${DB_HOST|localhost}"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Unsetenv("DB_HOST")
+
+	c.ExpandEnv()
+
+	if v, _ := c.GetString("url"); v != "localhost" {
+		t.Fatalf("url = %q, want default localhost", v)
+	}
+}
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LoadFiles : loads each path as JSON and deep-merges them in order into a
+// single Config, later sources overriding earlier ones (see Merge)
+func LoadFiles(paths ...string) (c Config, err error) {
+	c = Config{}
+	for _, path := range paths {
+		other, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		c.Merge(other)
+	}
+	return c, nil
+}
+
+// Merge : deep-merges other into c. Maps are merged key by key, with other's
+// value winning on conflict; by default slices are replaced wholesale rather
+// than appended.
+func (c *Config) Merge(other Config) {
+	mergeMaps(asMap(*c), asMap(other))
+}
+
+// MergeAppendSlices : like Merge, but when both sides hold a slice for the
+// same key, other's elements are appended to c's instead of replacing them
+func (c *Config) MergeAppendSlices(other Config) {
+	mergeMapsOpt(asMap(*c), asMap(other), true)
+}
+
+func mergeMaps(dst, src map[string]interface{}) {
+	mergeMapsOpt(dst, src, false)
+}
+
+func mergeMapsOpt(dst, src map[string]interface{}, appendSlices bool) {
+	for k, srcVal := range src {
+		dstVal, ok := dst[k]
+		if !ok {
+			dst[k] = srcVal
+			continue
+		}
+
+		dstMap, dstIsMap := dstVal.(map[string]interface{})
+		srcMap, srcIsMap := srcVal.(map[string]interface{})
+		if dstIsMap && srcIsMap {
+			mergeMapsOpt(dstMap, srcMap, appendSlices)
+			continue
+		}
+
+		if appendSlices {
+			dstSlice, dstIsSlice := dstVal.([]interface{})
+			srcSlice, srcIsSlice := srcVal.([]interface{})
+			if dstIsSlice && srcIsSlice {
+				dst[k] = append(dstSlice, srcSlice...)
+				continue
+			}
+		}
+
+		dst[k] = srcVal
+	}
+}
+
+// LoadWithEnvOverlay : loads path, then, if present, loads and merges
+// path's environment-suffixed sibling (e.g. "config.json" + env "prod" looks
+// for "config.prod.json") on top of it via Merge. The overlay file is
+// optional; its absence is not an error.
+func LoadWithEnvOverlay(path, env string) (Config, error) {
+	c, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	overlayPath := envOverlayPath(path, env)
+	if _, statErr := os.Stat(overlayPath); statErr != nil {
+		return c, nil
+	}
+
+	overlay, err := Load(overlayPath)
+	if err != nil {
+		return nil, err
+	}
+	c.Merge(overlay)
+	return c, nil
+}
+
+// envOverlayPath : turns ("config.json", "prod") into "config.prod.json"
+func envOverlayPath(path, env string) string {
+	ext := filepath.Ext(path)
+	base := strings.TrimSuffix(path, ext)
+	return base + "." + env + ext
+}
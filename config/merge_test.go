@@ -0,0 +1,102 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMergeDeepAndOverride(t *testing.T) {
+	base, err := Loads([]byte(`{"name":"base","database":{"host":"localhost","port":5432},"tags":["a","b"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	overlay, err := Loads([]byte(`{"name":"prod","database":{"host":"db.internal"},"tags":["c"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base.Merge(overlay)
+
+	if v, _ := base.GetString("name"); v != "prod" {
+		t.Fatalf("name = %q, want prod", v)
+	}
+	sub, err := base.GetSubConfig("database")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := sub.GetString("host"); v != "db.internal" {
+		t.Fatalf("database.host = %q, want db.internal (overlay should win)", v)
+	}
+	if v, _ := sub.GetInt64("port"); v != 5432 {
+		t.Fatalf("database.port = %d, want 5432 (untouched key preserved)", v)
+	}
+	tags, err := base.TryStringSlice("tags")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 1 || tags[0] != "c" {
+		t.Fatalf("tags = %v, want [c] (slices replaced wholesale by default)", tags)
+	}
+}
+
+func TestMergeAppendSlices(t *testing.T) {
+	base, err := Loads([]byte(`{"tags":["a","b"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	overlay, err := Loads([]byte(`{"tags":["c"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base.MergeAppendSlices(overlay)
+
+	tags, err := base.TryStringSlice("tags")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 3 || tags[0] != "a" || tags[1] != "b" || tags[2] != "c" {
+		t.Fatalf("tags = %v, want [a b c]", tags)
+	}
+}
+
+func TestLoadWithEnvOverlay(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "config.json")
+	prod := filepath.Join(dir, "config.prod.json")
+
+	if err := os.WriteFile(base, []byte(`{"name":"base","debug":true}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(prod, []byte(`{"debug":false}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := LoadWithEnvOverlay(base, "prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := c.GetString("name"); v != "base" {
+		t.Fatalf("name = %q, want base", v)
+	}
+	if v, err := c.TryBool("debug"); err != nil || v != false {
+		t.Fatalf("debug = %v, %v, want false", v, err)
+	}
+}
+
+func TestLoadWithEnvOverlayMissingIsNotError(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(base, []byte(`{"name":"base"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := LoadWithEnvOverlay(base, "staging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := c.GetString("name"); v != "base" {
+		t.Fatalf("name = %q, want base", v)
+	}
+}
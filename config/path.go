@@ -0,0 +1,128 @@
+package config
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrPathNotFound : returned by GetByPath when an intermediate path segment
+// does not resolve, as opposed to ErrNoSuchKey which signals that the final
+// key itself is missing
+var ErrPathNotFound = errors.New("No such path")
+
+// pathSep : separator between path segments, e.g. "server.listeners.0.port".
+// A literal dot in a key can be matched by escaping it as "\."
+const pathSep = "."
+
+// splitPath : splits a dotted path into its segments, honoring "\." as an
+// escape for a literal dot inside a single segment
+func splitPath(path string) []string {
+	var segments []string
+	var cur strings.Builder
+	escaped := false
+	for _, r := range path {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case string(r) == pathSep:
+			segments = append(segments, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	segments = append(segments, cur.String())
+	return segments
+}
+
+// GetByPath : walks a dotted path through nested maps and slices, where a
+// numeric segment indexes into a []interface{}, e.g.
+// GetByPath("server.listeners.0.port")
+func (c *Config) GetByPath(path string) (Value, error) {
+	segments := splitPath(path)
+
+	var cur Value = asMap(*c)
+	for i, seg := range segments {
+		switch node := cur.(type) {
+		case map[string]interface{}:
+			val, ok := node[seg]
+			if !ok {
+				if i == len(segments)-1 {
+					return nil, ErrNoSuchKey
+				}
+				return nil, ErrPathNotFound
+			}
+			cur = val
+		case Config:
+			val, ok := node[seg]
+			if !ok {
+				if i == len(segments)-1 {
+					return nil, ErrNoSuchKey
+				}
+				return nil, ErrPathNotFound
+			}
+			cur = val
+		case []interface{}:
+			idx, err := strconv.Atoi(seg)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, ErrPathNotFound
+			}
+			cur = node[idx]
+		default:
+			return nil, ErrPathNotFound
+		}
+	}
+	return cur, nil
+}
+
+func (c *Config) GetStringByPath(path string) (string, error) {
+	val, err := c.GetByPath(path)
+	if err != nil {
+		return "", err
+	}
+	s, ok := val.(string)
+	if !ok {
+		return "", ErrWrongType
+	}
+	return s, nil
+}
+
+func (c *Config) GetInt64ByPath(path string) (int64, error) {
+	val, err := c.GetByPath(path)
+	if err != nil {
+		return 0, err
+	}
+	f, ok := val.(float64)
+	if !ok {
+		return 0, ErrWrongType
+	}
+	return int64(f), nil
+}
+
+func (c *Config) GetFloat64ByPath(path string) (float64, error) {
+	val, err := c.GetByPath(path)
+	if err != nil {
+		return 0, err
+	}
+	f, ok := val.(float64)
+	if !ok {
+		return 0, ErrWrongType
+	}
+	return f, nil
+}
+
+func (c *Config) GetBoolByPath(path string) (bool, error) {
+	val, err := c.GetByPath(path)
+	if err != nil {
+		return false, err
+	}
+	b, ok := val.(bool)
+	if !ok {
+		return false, ErrWrongType
+	}
+	return b, nil
+}
@@ -0,0 +1,78 @@
+package config
+
+import "testing"
+
+func TestGetByPathNested(t *testing.T) {
+	c, err := Loads([]byte(`{"server":{"listeners":[{"port":8080},{"port":9090}]}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := c.GetInt64ByPath("server.listeners.0.port"); err != nil || v != 8080 {
+		t.Fatalf("listeners.0.port = %v, %v", v, err)
+	}
+	if v, err := c.GetInt64ByPath("server.listeners.1.port"); err != nil || v != 9090 {
+		t.Fatalf("listeners.1.port = %v, %v", v, err)
+	}
+}
+
+func TestGetByPathEscapedDot(t *testing.T) {
+	c, err := Loads([]byte(`{"a.b":{"c":"v"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := c.GetStringByPath(`a\.b.c`); err != nil || v != "v" {
+		t.Fatalf("escaped path = %q, %v", v, err)
+	}
+}
+
+func TestGetByPathNoSuchKey(t *testing.T) {
+	c, err := Loads([]byte(`{"server":{"host":"localhost"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetByPath("server.missing"); err != ErrNoSuchKey {
+		t.Fatalf("err = %v, want ErrNoSuchKey", err)
+	}
+}
+
+func TestGetByPathNotFound(t *testing.T) {
+	c, err := Loads([]byte(`{"server":{"host":"localhost"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetByPath("server.missing.deeper"); err != ErrPathNotFound {
+		t.Fatalf("err = %v, want ErrPathNotFound", err)
+	}
+	if _, err := c.GetByPath("nope.at.all"); err != ErrPathNotFound {
+		t.Fatalf("err = %v, want ErrPathNotFound", err)
+	}
+}
+
+func TestGetByPathSliceIndexOutOfRange(t *testing.T) {
+	c, err := Loads([]byte(`{"items":[1,2]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetByPath("items.5"); err != ErrPathNotFound {
+		t.Fatalf("err = %v, want ErrPathNotFound", err)
+	}
+	if _, err := c.GetByPath("items.notanumber"); err != ErrPathNotFound {
+		t.Fatalf("err = %v, want ErrPathNotFound", err)
+	}
+}
+
+func TestGetByPathWrongType(t *testing.T) {
+	c, err := Loads([]byte(`{"name":"svc"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetInt64ByPath("name"); err != ErrWrongType {
+		t.Fatalf("err = %v, want ErrWrongType", err)
+	}
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+)
+
+// Provider : interface implemented by format adapters so that Config can be
+// parsed from different serializations (json, yaml, toml, ini, ...)
+type Provider interface {
+	// Parse : parses the configuration stored at path
+	Parse(path string) (Config, error)
+	// ParseData : parses the configuration from an in memory buffer
+	ParseData(data []byte) (Config, error)
+}
+
+var (
+	// providers holds the registry of Provider implementations indexed by
+	// adapter name (e.g. "json", "yaml", "toml", "ini")
+	providers = map[string]Provider{}
+)
+
+func init() {
+	Register("json", jsonProvider{})
+	Register("yaml", yamlProvider{})
+	Register("yml", yamlProvider{})
+	Register("toml", tomlProvider{})
+	Register("ini", iniProvider{})
+}
+
+// Register : registers a Provider under the given adapter name, overwriting
+// any previously registered provider with the same name. This lets third
+// parties plug in additional formats without modifying this package.
+func Register(name string, p Provider) {
+	providers[name] = p
+}
+
+// NewConfig : loads a configuration file using the provider registered under
+// adapterName (e.g. "json", "yaml", "toml", "ini")
+// return (Config, error) : the Config struct parsed, error code
+func NewConfig(adapterName, path string) (c Config, err error) {
+	p, ok := providers[adapterName]
+	if !ok {
+		return nil, fmt.Errorf("no config provider registered for adapter %q", adapterName)
+	}
+	return p.Parse(path)
+}
+
+// NewConfigData : parses data using the provider registered under adapterName
+// return (Config, error) : the Config struct parsed, error code
+func NewConfigData(adapterName string, data []byte) (c Config, err error) {
+	p, ok := providers[adapterName]
+	if !ok {
+		return nil, fmt.Errorf("no config provider registered for adapter %q", adapterName)
+	}
+	return p.ParseData(data)
+}
+
+// readFile : reads the whole file at path, shared by the built-in providers
+func readFile(path string) ([]byte, error) {
+	return ioutil.ReadFile(path)
+}
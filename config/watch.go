@@ -0,0 +1,132 @@
+package config
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/0xrawsec/golang-utils/log"
+)
+
+// WatchedConfig : wraps a Config loaded from a file and keeps it up to date
+// as the file changes on disk, so that long running services can pick up
+// configuration changes without a restart.
+type WatchedConfig struct {
+	path        string
+	current     atomic.Value
+	watcher     *fsnotify.Watcher
+	mu          sync.Mutex
+	subscribers []chan Config
+	onChange    []func(old, new Config)
+	close       chan struct{}
+}
+
+// Watch : loads the configuration stored at path and starts watching it for
+// changes. Call Close to stop the underlying watcher goroutine.
+func Watch(path string) (*WatchedConfig, error) {
+	c, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := watcher.Add(path); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	wc := &WatchedConfig{
+		path:    path,
+		watcher: watcher,
+		close:   make(chan struct{}),
+	}
+	wc.current.Store(c)
+
+	go wc.run()
+	return wc, nil
+}
+
+// Get : returns the current configuration snapshot. Safe for concurrent use
+// with reloads happening in the background.
+func (wc *WatchedConfig) Get() Config {
+	return wc.current.Load().(Config)
+}
+
+// Subscribe : returns a channel on which reloaded Configs are sent. The
+// channel has a buffer of one and sends never block: a reload that finds the
+// buffer still full is not delivered to that subscriber. The channel is never
+// closed by WatchedConfig; stop reading from it once the subscriber no longer
+// cares.
+func (wc *WatchedConfig) Subscribe() <-chan Config {
+	ch := make(chan Config, 1)
+	wc.mu.Lock()
+	wc.subscribers = append(wc.subscribers, ch)
+	wc.mu.Unlock()
+	return ch
+}
+
+// OnChange : registers a callback invoked with the old and new Config every
+// time the watched file is successfully reloaded
+func (wc *WatchedConfig) OnChange(f func(old, new Config)) {
+	wc.mu.Lock()
+	wc.onChange = append(wc.onChange, f)
+	wc.mu.Unlock()
+}
+
+// Close : stops the watcher goroutine and releases the underlying fsnotify
+// watcher
+func (wc *WatchedConfig) Close() error {
+	close(wc.close)
+	return wc.watcher.Close()
+}
+
+func (wc *WatchedConfig) run() {
+	for {
+		select {
+		case <-wc.close:
+			return
+
+		case event, ok := <-wc.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			wc.reload()
+
+		case err, ok := <-wc.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Errorf("config watcher error on %s: %s", wc.path, err)
+		}
+	}
+}
+
+func (wc *WatchedConfig) reload() {
+	next, err := Load(wc.path)
+	if err != nil {
+		log.Errorf("failed to reload config %s: %s", wc.path, err)
+		return
+	}
+
+	old := wc.Get()
+	wc.current.Store(next)
+
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+	for _, ch := range wc.subscribers {
+		select {
+		case ch <- next:
+		default:
+		}
+	}
+	for _, f := range wc.onChange {
+		f(old, next)
+	}
+}
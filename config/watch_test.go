@@ -0,0 +1,88 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatchReloadsOnWrite(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(path, []byte(`{"name":"v1"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	wc, err := Watch(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wc.Close()
+
+	initial := wc.Get()
+	if v, _ := initial.GetString("name"); v != "v1" {
+		t.Fatalf("initial name = %q, want v1", v)
+	}
+
+	sub := wc.Subscribe()
+
+	// a single os.WriteFile may produce more than one Write event, hence more
+	// than one reload; only the first callback is recorded
+	changed := make(chan struct{})
+	var once sync.Once
+	var gotOld, gotNew Config
+	wc.OnChange(func(old, new Config) {
+		once.Do(func() {
+			gotOld, gotNew = old, new
+			close(changed)
+		})
+	})
+
+	if err := os.WriteFile(path, []byte(`{"name":"v2"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case next := <-sub:
+		if v, _ := next.GetString("name"); v != "v2" {
+			t.Fatalf("reloaded name = %q, want v2", v)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for reload")
+	}
+
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for OnChange callback")
+	}
+
+	reloaded := wc.Get()
+	if v, _ := reloaded.GetString("name"); v != "v2" {
+		t.Fatalf("Get() after reload = %q, want v2", v)
+	}
+	if v, _ := gotOld.GetString("name"); v != "v1" {
+		t.Fatalf("OnChange old = %q, want v1", v)
+	}
+	if v, _ := gotNew.GetString("name"); v != "v2" {
+		t.Fatalf("OnChange new = %q, want v2", v)
+	}
+}
+
+func TestWatchClose(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(path, []byte(`{"name":"v1"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	wc, err := Watch(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := wc.Close(); err != nil {
+		t.Fatal(err)
+	}
+}